@@ -0,0 +1,50 @@
+package tui
+
+import "testing"
+
+func TestFilterMatcher(t *testing.T) {
+	data := TableData{
+		{"KEY", "SUMMARY", "STATUS"},
+		{"ISS-1", "fix login bug", "TO DO"},
+		{"ISS-2", "update docs", "IN PROGRESS"},
+		{"ISS-3", "refactor parser", "DONE"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string // expected KEY column of matching rows
+	}{
+		{"empty query matches everything", "", []string{"ISS-1", "ISS-2", "ISS-3"}},
+		{"bare word matches any column", "docs", []string{"ISS-2"}},
+		{"column-scoped token", "status:\"to do\"", []string{"ISS-1"}},
+		{"column-scoped token without quotes", "status:done", []string{"ISS-3"}},
+		{"multiple tokens are ANDed", "status:\"in progress\" docs", []string{"ISS-2"}},
+		{"unknown column falls back to whole-row match", "nosuchcol:zzyzx", nil},
+		{"regex prefix", "re:ISS-[12]", []string{"ISS-1", "ISS-2"}},
+		{"invalid regex matches nothing", "re:(", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{allData: data, filterQuery: tt.query}
+			match := tbl.filterMatcher()
+
+			var got []string
+			for _, row := range data[1:] {
+				if match(row) {
+					got = append(got, row[0])
+				}
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("filterMatcher(%q) matched %v, want %v", tt.query, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("filterMatcher(%q) matched %v, want %v", tt.query, got, tt.want)
+				}
+			}
+		})
+	}
+}
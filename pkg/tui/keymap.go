@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Action is a semantic keyboard action the table recognizes.
+type Action string
+
+const (
+	ActionRefresh           Action = "refresh"
+	ActionView              Action = "view"
+	ActionMove              Action = "move"
+	ActionCopy              Action = "copy"
+	ActionCopyKey           Action = "copy-key"
+	ActionHelp              Action = "help"
+	ActionQuit              Action = "quit"
+	ActionSpeakCell         Action = "speak-cell"
+	ActionAccessibilityHelp Action = "accessibility-help"
+	ActionAutoRefreshToggle Action = "auto-refresh"
+	ActionSort              Action = "sort"
+	ActionFilter            Action = "filter"
+	ActionContextMenu       Action = "context-menu"
+	ActionToggleSelect      Action = "toggle-select"
+	ActionSelectAll         Action = "select-all"
+	ActionClearSelection    Action = "clear-selection"
+	ActionNextMatch         Action = "next-match"
+	ActionPrevMatch         Action = "prev-match"
+)
+
+// Binding is a single key or rune bound to an action.
+type Binding struct {
+	Key  tcell.Key
+	Rune rune
+}
+
+// String returns a human-readable name for the binding, e.g. "Ctrl-R" or "v".
+func (b Binding) String() string {
+	if b.Key == tcell.KeyRune {
+		return string(b.Rune)
+	}
+	if name, ok := tcell.KeyNames[b.Key]; ok {
+		return name
+	}
+	return fmt.Sprintf("key(%d)", b.Key)
+}
+
+// KeyMap maps semantic actions to one or more key bindings.
+type KeyMap map[Action][]Binding
+
+// DefaultKeyMap returns the table's built-in keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		ActionRefresh:           {{Key: tcell.KeyCtrlR}, {Key: tcell.KeyF5}},
+		ActionView:              {{Key: tcell.KeyRune, Rune: 'v'}},
+		ActionMove:              {{Key: tcell.KeyRune, Rune: 'm'}},
+		ActionCopy:              {{Key: tcell.KeyRune, Rune: 'c'}},
+		ActionCopyKey:           {{Key: tcell.KeyCtrlK}},
+		ActionHelp:              {{Key: tcell.KeyRune, Rune: '?'}},
+		ActionQuit:              {{Key: tcell.KeyRune, Rune: 'q'}},
+		ActionSpeakCell:         {{Key: tcell.KeyCtrlS}},
+		ActionAccessibilityHelp: {{Key: tcell.KeyCtrlA}},
+		ActionAutoRefreshToggle: {{Key: tcell.KeyRune, Rune: 'R'}},
+		ActionSort:              {{Key: tcell.KeyEnter}},
+		ActionFilter:            {{Key: tcell.KeyRune, Rune: '/'}},
+		ActionContextMenu:       {{Key: tcell.KeyCtrlSpace}},
+		ActionToggleSelect:      {{Key: tcell.KeyRune, Rune: ' '}},
+		ActionSelectAll:         {{Key: tcell.KeyRune, Rune: '*'}},
+		ActionClearSelection:    {{Key: tcell.KeyEsc}},
+		ActionNextMatch:         {{Key: tcell.KeyRune, Rune: 'n'}},
+		ActionPrevMatch:         {{Key: tcell.KeyRune, Rune: 'N'}},
+	}
+}
+
+// Matches reports whether ev is bound to action in the key map.
+func (km KeyMap) Matches(ev *tcell.EventKey, action Action) bool {
+	for _, b := range km[action] {
+		if b.Key == tcell.KeyRune {
+			if ev.Key() == tcell.KeyRune && ev.Rune() == b.Rune {
+				return true
+			}
+			continue
+		}
+		if ev.Key() == b.Key {
+			return true
+		}
+	}
+	return false
+}
+
+// validate rejects key maps where the same binding is assigned to more than
+// one action.
+func (km KeyMap) validate() error {
+	seen := make(map[Binding]Action)
+	for action, bindings := range km {
+		for _, b := range bindings {
+			if other, ok := seen[b]; ok && other != action {
+				return fmt.Errorf("keymap: %q is bound to both %q and %q", b, other, action)
+			}
+			seen[b] = action
+		}
+	}
+	return nil
+}
+
+// keyNamesByName is the reverse of tcell.KeyNames, used to parse named keys
+// such as "Enter" or "Ctrl-R" out of the keymap file. Names are normalized the
+// same way as parseBinding's input so "Ctrl-R" and "Ctrl+R" both resolve.
+var keyNamesByName = func() map[string]tcell.Key {
+	m := make(map[string]tcell.Key, len(tcell.KeyNames))
+	for k, name := range tcell.KeyNames {
+		m[strings.ToLower(strings.ReplaceAll(name, "-", "+"))] = k
+	}
+	return m
+}()
+
+func parseBinding(s string) (Binding, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Binding{}, fmt.Errorf("empty key binding")
+	}
+
+	norm := strings.ToLower(strings.ReplaceAll(s, "-", "+"))
+	if k, ok := keyNamesByName[norm]; ok {
+		return Binding{Key: k}, nil
+	}
+
+	runes := []rune(s)
+	if len(runes) == 1 {
+		return Binding{Key: tcell.KeyRune, Rune: runes[0]}, nil
+	}
+	return Binding{}, fmt.Errorf("unrecognized key binding %q", s)
+}
+
+// keyMapPath returns the location of the user's keymap file, honoring
+// $XDG_CONFIG_HOME.
+func keyMapPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err == nil {
+			dir = filepath.Join(home, ".config")
+		}
+	}
+	return filepath.Join(dir, "jira-cli", "keys.yml")
+}
+
+// LoadKeyMap loads the keymap file from $XDG_CONFIG_HOME/jira-cli/keys.yml, if
+// present, merging its bindings over DefaultKeyMap. It returns an error if the
+// file exists but is malformed or assigns conflicting bindings.
+func LoadKeyMap() (KeyMap, error) {
+	km := DefaultKeyMap()
+
+	data, err := os.ReadFile(keyMapPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return km, nil
+		}
+		return nil, fmt.Errorf("read keymap: %w", err)
+	}
+
+	var raw map[string][]string
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse keymap: %w", err)
+	}
+
+	for action, bindings := range raw {
+		parsed := make([]Binding, 0, len(bindings))
+		for _, b := range bindings {
+			binding, err := parseBinding(b)
+			if err != nil {
+				return nil, fmt.Errorf("action %q: %w", action, err)
+			}
+			parsed = append(parsed, binding)
+		}
+		km[Action(action)] = parsed
+	}
+
+	if err := km.validate(); err != nil {
+		return nil, err
+	}
+	return km, nil
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestParseBinding(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Binding
+		wantErr bool
+	}{
+		{name: "single rune", input: "v", want: Binding{Key: tcell.KeyRune, Rune: 'v'}},
+		{name: "named key", input: "Enter", want: Binding{Key: tcell.KeyEnter}},
+		{name: "ctrl dash", input: "Ctrl-R", want: Binding{Key: tcell.KeyCtrlR}},
+		{name: "ctrl plus", input: "Ctrl+R", want: Binding{Key: tcell.KeyCtrlR}},
+		{name: "trims whitespace", input: "  Esc  ", want: Binding{Key: tcell.KeyEsc}},
+		{name: "empty", input: "", wantErr: true},
+		{name: "unrecognized multi-char", input: "Blorp", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBinding(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseBinding(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseBinding(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseBinding(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyMapValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		km      KeyMap
+		wantErr bool
+	}{
+		{
+			name: "no conflicts",
+			km: KeyMap{
+				ActionView: {{Key: tcell.KeyRune, Rune: 'v'}},
+				ActionMove: {{Key: tcell.KeyRune, Rune: 'm'}},
+			},
+		},
+		{
+			name: "same binding same action repeated",
+			km: KeyMap{
+				ActionView: {{Key: tcell.KeyRune, Rune: 'v'}, {Key: tcell.KeyRune, Rune: 'v'}},
+			},
+		},
+		{
+			name: "conflicting binding",
+			km: KeyMap{
+				ActionSort: {{Key: tcell.KeyEnter}},
+				ActionView: {{Key: tcell.KeyEnter}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.km.validate()
+			if tt.wantErr && err == nil {
+				t.Fatalf("validate() = nil, want error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("validate() returned unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDefaultKeyMapValidates(t *testing.T) {
+	if err := DefaultKeyMap().validate(); err != nil {
+		t.Fatalf("DefaultKeyMap() is internally conflicting: %v", err)
+	}
+}
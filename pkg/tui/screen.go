@@ -1,16 +1,38 @@
 package tui
 
 import (
+	"encoding/binary"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/rivo/tview"
 )
 
+// announceDebounce cancels any pending announcement superseded by a newer one
+// within this window, so arrow-key scrolling doesn't produce a speech backlog.
+const announceDebounce = 150 * time.Millisecond
+
+// Announcer delivers a single announcement to an assistive technology.
+type Announcer interface {
+	Announce(text string)
+}
+
 // Screen is a shell screen.
 type Screen struct {
 	*tview.Application
 	accessibilityEnabled bool
+	announcer            Announcer
+
+	announceMu    sync.Mutex
+	announceTimer *time.Timer
 }
 
 // NewScreen creates a new screen.
@@ -18,10 +40,15 @@ func NewScreen() *Screen {
 	app := tview.NewApplication()
 	// Check if accessibility is enabled via env var
 	_, accessibilityEnabled := os.LookupEnv("JIRA_ACCESSIBILITY_MODE")
-	return &Screen{
+
+	s := &Screen{
 		Application:          app,
 		accessibilityEnabled: accessibilityEnabled,
 	}
+	if accessibilityEnabled {
+		s.announcer = detectAnnouncer()
+	}
+	return s
 }
 
 // Paint paints UI to the screen.
@@ -29,23 +56,221 @@ func (s *Screen) Paint(root tview.Primitive) error {
 	return s.SetRoot(root, true).SetFocus(root).Run()
 }
 
-// For accessibility announcements, we hide all output from the user and only
-// send it to screen readers using a feature of the terminal called ANSI escape codes.
-// This approach ensures screen reader announcements don't corrupt the UI.
-
-// AnnounceToScreenReader outputs text specifically formatted for screen readers without breaking the UI.
+// AnnounceToScreenReader delivers announcement to the detected assistive
+// technology backend. It never writes to stderr, since tview owns the
+// terminal while the screen is painted. Announcements are debounced so a
+// burst of selection changes (e.g. holding an arrow key) speaks only the
+// last one.
 func (s *Screen) AnnounceToScreenReader(announcement string) {
-	if s.accessibilityEnabled {
-		// Create the formatted announcement
-		text := fmt.Sprintf("[SCREEN_READER_ANNOUNCEMENT] %s", announcement)
-		
-		// Use ANSI escape sequences to make text invisible to users but available to screen readers
-		// \033[8m is the "conceal" escape code 
-		// The screen reader will still read it but it won't show on screen
-		invisibleText := fmt.Sprintf("\033[8m%s\033[0m", text)
-		
-		// Position at the end of the line and output invisibly
-		// This avoids messing up the visible UI
-		fmt.Fprint(os.Stderr, invisibleText)
+	if !s.accessibilityEnabled || s.announcer == nil || announcement == "" {
+		return
+	}
+
+	s.announceMu.Lock()
+	defer s.announceMu.Unlock()
+
+	if s.announceTimer != nil {
+		s.announceTimer.Stop()
+	}
+	announcer := s.announcer
+	s.announceTimer = time.AfterFunc(announceDebounce, func() {
+		announcer.Announce(announcement)
+	})
+}
+
+// detectAnnouncer picks the best available Announcer for the current
+// platform and environment, probing $PATH for each native backend in turn.
+// It falls back to writing to a named pipe so external assistive
+// technologies can subscribe, and returns nil if nothing is available.
+func detectAnnouncer() Announcer {
+	if host := os.Getenv("BRLAPI_HOST"); host != "" {
+		return brlttyAnnouncer{host: host}
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		if bin, err := exec.LookPath("spd-say"); err == nil {
+			return speechDispatcherAnnouncer{bin: bin}
+		}
+	case "darwin":
+		if bin, err := exec.LookPath("say"); err == nil {
+			return sayAnnouncer{bin: bin}
+		}
+	case "windows":
+		if bin, err := exec.LookPath("powershell"); err == nil {
+			return sapiAnnouncer{bin: bin}
+		}
+	}
+
+	if pipe := os.Getenv("JIRA_A11Y_PIPE"); pipe != "" {
+		return namedPipeAnnouncer{path: pipe}
+	}
+
+	return nil
+}
+
+// speechDispatcherAnnouncer speaks via speech-dispatcher's spd-say on Linux.
+type speechDispatcherAnnouncer struct{ bin string }
+
+func (a speechDispatcherAnnouncer) Announce(text string) {
+	_ = exec.Command(a.bin, "--priority=message", text).Run()
+}
+
+// sayAnnouncer speaks via macOS's NSSpeechSynthesizer-backed `say` command.
+type sayAnnouncer struct{ bin string }
+
+func (a sayAnnouncer) Announce(text string) {
+	_ = exec.Command(a.bin, text).Run()
+}
+
+// sapiAnnouncer speaks via Windows SAPI, driven through PowerShell.
+type sapiAnnouncer struct{ bin string }
+
+func (a sapiAnnouncer) Announce(text string) {
+	script := fmt.Sprintf(
+		"Add-Type -AssemblyName System.Speech; "+
+			"(New-Object System.Speech.Synthesis.SpeechSynthesizer).Speak('%s')",
+		strings.ReplaceAll(text, "'", "''"),
+	)
+	_ = exec.Command(a.bin, "-NoProfile", "-Command", script).Run()
+}
+
+// BrlAPI packet types and protocol version, per BRLTTY's brlapi_protocol.h.
+// Only the subset needed to negotiate a session and push text is used here;
+// key auth (BRLAPI_AUTH_KEY) is out of scope, so this only works against a
+// server configured for BRLAPI_AUTH_NONE (the common local/trusted-host setup).
+const (
+	brlapiProtocolVersion = 8
+
+	brlapiPacketVersion      = uint32('v')<<24 | uint32('e')<<16 | uint32('r')<<8 | uint32('s')
+	brlapiPacketAuth         = uint32('a')<<24 | uint32('u')<<16 | uint32('t')<<8 | uint32('h')
+	brlapiPacketError        = uint32('e')<<24 | uint32('r')<<16 | uint32('r')<<8 | uint32(' ')
+	brlapiPacketEnterTTYMode = uint32('t')<<24 | uint32('t')<<16 | uint32('y')<<8 | uint32('m')
+	brlapiPacketLeaveTTYMode = uint32('t')<<24 | uint32('t')<<16 | uint32('y')<<8 | uint32('l')
+	brlapiPacketWrite        = uint32('w')<<24 | uint32('r')<<16 | uint32('i')<<8 | uint32('t')
+	brlapiAuthNone           = uint32(0)
+	brlapiWriteFlagText      = uint32(1 << 7)
+	brlapiDefaultTTY         = uint32(0)
+)
+
+// brlttyAnnouncer forwards announcements to a BrlAPI server so they reach a
+// refreshable Braille display, addressed via BRLAPI_HOST (host:port). Each
+// announcement opens a fresh session: version handshake, unauthenticated
+// auth exchange, enter tty mode, write the text, leave tty mode.
+type brlttyAnnouncer struct{ host string }
+
+func (a brlttyAnnouncer) Announce(text string) {
+	conn, err := net.DialTimeout("tcp", a.host, time.Second)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+
+	if !brlapiNegotiate(conn) {
+		return
+	}
+	if !brlapiEnterTTYMode(conn, brlapiDefaultTTY) {
+		return
+	}
+	defer func() { _ = brlapiWritePacket(conn, brlapiPacketLeaveTTYMode, nil) }()
+
+	brlapiWriteText(conn, text)
+}
+
+// brlapiNegotiate performs the version and (unauthenticated) auth handshake
+// that every BrlAPI session starts with.
+func brlapiNegotiate(conn net.Conn) bool {
+	typ, _, err := brlapiReadPacket(conn)
+	if err != nil || typ != brlapiPacketVersion {
+		return false
+	}
+
+	payload := make([]byte, 4)
+	binary.BigEndian.PutUint32(payload, brlapiProtocolVersion)
+	if err := brlapiWritePacket(conn, brlapiPacketVersion, payload); err != nil {
+		return false
+	}
+
+	authPayload := make([]byte, 4)
+	binary.BigEndian.PutUint32(authPayload, brlapiAuthNone)
+	if err := brlapiWritePacket(conn, brlapiPacketAuth, authPayload); err != nil {
+		return false
+	}
+
+	typ, _, err = brlapiReadPacket(conn)
+	return err == nil && typ != brlapiPacketError
+}
+
+// brlapiEnterTTYMode requests exclusive control of the given tty number,
+// which BrlAPI requires before any WRITE packet is accepted.
+func brlapiEnterTTYMode(conn net.Conn, tty uint32) bool {
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint32(payload[0:4], 1) // one tty path component
+	binary.BigEndian.PutUint32(payload[4:8], tty)
+	if err := brlapiWritePacket(conn, brlapiPacketEnterTTYMode, payload); err != nil {
+		return false
+	}
+	typ, _, err := brlapiReadPacket(conn)
+	return err == nil && typ != brlapiPacketError
+}
+
+// brlapiWriteText sends a WRITE packet with only the text field populated,
+// the minimal payload a BrlAPI server accepts: a flags word followed by the
+// length-prefixed text for whichever fields the flags declare present.
+func brlapiWriteText(conn net.Conn, text string) {
+	b := []byte(text)
+	payload := make([]byte, 0, 4+1+len(b))
+	flags := make([]byte, 4)
+	binary.BigEndian.PutUint32(flags, brlapiWriteFlagText)
+	payload = append(payload, flags...)
+	payload = append(payload, byte(len(b)))
+	payload = append(payload, b...)
+	_ = brlapiWritePacket(conn, brlapiPacketWrite, payload)
+}
+
+// brlapiWritePacket frames payload as a BrlAPI packet: a 4-byte big-endian
+// length, a 4-byte big-endian packet type, then the payload.
+func brlapiWritePacket(conn net.Conn, typ uint32, payload []byte) error {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(header[4:8], typ)
+	if _, err := conn.Write(header); err != nil {
+		return err
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	_, err := conn.Write(payload)
+	return err
+}
+
+// brlapiReadPacket reads one framed BrlAPI packet from conn.
+func brlapiReadPacket(conn net.Conn) (uint32, []byte, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return 0, nil, err
+	}
+	size := binary.BigEndian.Uint32(header[0:4])
+	typ := binary.BigEndian.Uint32(header[4:8])
+	payload := make([]byte, size)
+	if size > 0 {
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			return 0, nil, err
+		}
+	}
+	return typ, payload, nil
+}
+
+// namedPipeAnnouncer writes announcements to a named pipe so external
+// assistive technologies can subscribe independently of this process.
+type namedPipeAnnouncer struct{ path string }
+
+func (a namedPipeAnnouncer) Announce(text string) {
+	f, err := os.OpenFile(a.path, os.O_WRONLY|syscall.O_NONBLOCK, os.ModeNamedPipe)
+	if err != nil {
+		return
 	}
+	defer f.Close()
+	fmt.Fprintln(f, text)
 }
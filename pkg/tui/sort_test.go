@@ -0,0 +1,65 @@
+package tui
+
+import "testing"
+
+func TestNumericComparator(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"ascending numbers", "2", "10", true},
+		{"descending numbers", "10", "2", false},
+		{"equal", "5", "5", false},
+		{"non-numeric falls back to lexical", "b", "a", false},
+		{"mixed numeric and non-numeric", "1", "a", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := numericComparator(tt.a, tt.b); got != tt.want {
+				t.Errorf("numericComparator(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateComparator(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"earlier before later", "2024-01-01 10:00:00", "2024-06-01 10:00:00", true},
+		{"later before earlier", "2024-06-01 10:00:00", "2024-01-01 10:00:00", false},
+		{"unparseable falls back to lexical", "not-a-date", "also-not", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dateComparator(tt.a, tt.b); got != tt.want {
+				t.Errorf("dateComparator(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedComparator(t *testing.T) {
+	cmp := orderedComparator(statusOrder)
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"to do before in progress", "TO DO", "IN PROGRESS", true},
+		{"done after in progress", "DONE", "IN PROGRESS", false},
+		{"case insensitive", "to do", "done", true},
+		{"unknown value sorts after known", "UNKNOWN", "TO DO", false},
+		{"two unknown values fall back lexically", "ZULU", "ALPHA", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmp(tt.a, tt.b); got != tt.want {
+				t.Errorf("cmp(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
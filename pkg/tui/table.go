@@ -3,7 +3,11 @@ package tui
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
@@ -43,6 +47,206 @@ type CopyFunc func(row, column int, data interface{})
 // CopyKeyFunc is fired when a user press 'CTRL+K' character in the table cell.
 type CopyKeyFunc func(row, column int, data interface{})
 
+// BulkMoveHandlerFunc transitions the issue at the given data row to state.
+type BulkMoveHandlerFunc func(row int, state string) error
+
+// BulkMoveFunc is fired when a user press 'm' with one or more rows selected.
+type BulkMoveFunc func(rows []int) func() (actions []string, handler BulkMoveHandlerFunc, refresh RefreshTableStateFunc)
+
+// BulkCopyFunc is fired when a user press 'c' with one or more rows selected.
+type BulkCopyFunc func(rows []int, data TableData)
+
+// selectionBgColor highlights rows selected for a bulk action.
+const selectionBgColor = tcell.ColorDarkSlateBlue
+
+// MenuItem is a single entry in a row's context menu.
+type MenuItem struct {
+	Label    string
+	Shortcut string
+	Handler  func(row, col int, data TableData)
+}
+
+// ContextMenuFunc builds the context menu entries for the row under the cursor.
+type ContextMenuFunc func(row, col int, data TableData) []MenuItem
+
+// CellStyler decides how a single table cell should be styled.
+type CellStyler interface {
+	Style(header, value string, row int) tcell.Style
+}
+
+// CellStylerFunc adapts a plain function to the CellStyler interface.
+type CellStylerFunc func(header, value string, row int) tcell.Style
+
+// Style implements CellStyler.
+func (f CellStylerFunc) Style(header, value string, row int) tcell.Style {
+	return f(header, value, row)
+}
+
+// ColumnSpec configures the layout of a single column, identified by its header.
+type ColumnSpec struct {
+	Header    string
+	Expansion int
+	Align     int
+	MaxWidth  uint
+}
+
+// defaultCellStyler colors cells based on well-known JIRA column semantics and
+// dims rows whose issue is resolved.
+type defaultCellStyler struct {
+	data TableData
+}
+
+func (s defaultCellStyler) Style(header, value string, row int) tcell.Style {
+	style := tcell.StyleDefault.Foreground(tcell.ColorDefault)
+
+	switch strings.ToUpper(header) {
+	case "STATUS":
+		style = style.Foreground(statusColor(value))
+	case "PRIORITY":
+		style = style.Foreground(priorityColor(value))
+	case "TYPE":
+		style = style.Foreground(typeColor(value))
+	}
+
+	if s.resolved(row) {
+		style = style.Foreground(tcell.ColorGray).Dim(true)
+	}
+	return style
+}
+
+func (s defaultCellStyler) resolved(row int) bool {
+	idx := s.data.GetIndex("STATUS")
+	if idx < 0 || row <= 0 || row >= len(s.data) {
+		return false
+	}
+	return statusCategory(s.data.Get(row, idx)) == "DONE"
+}
+
+func statusCategory(value string) string {
+	v := strings.ToUpper(strings.TrimSpace(value))
+	switch {
+	case strings.Contains(v, "DONE"), strings.Contains(v, "CLOSED"), strings.Contains(v, "RESOLVED"):
+		return "DONE"
+	case strings.Contains(v, "PROGRESS"), strings.Contains(v, "REVIEW"):
+		return "IN PROGRESS"
+	default:
+		return "TO DO"
+	}
+}
+
+func statusColor(value string) tcell.Color {
+	switch statusCategory(value) {
+	case "DONE":
+		return tcell.ColorGreen
+	case "IN PROGRESS":
+		return tcell.ColorYellow
+	default:
+		return tcell.ColorGray
+	}
+}
+
+func priorityColor(value string) tcell.Color {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "HIGHEST", "HIGH":
+		return tcell.ColorRed
+	case "MEDIUM":
+		return tcell.ColorYellow
+	case "LOW", "LOWEST":
+		return tcell.ColorGreen
+	default:
+		return tcell.ColorDefault
+	}
+}
+
+func typeColor(value string) tcell.Color {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "BUG":
+		return tcell.ColorRed
+	case "STORY":
+		return tcell.ColorGreen
+	case "TASK":
+		return tcell.ColorBlue
+	case "EPIC":
+		return tcell.ColorDarkMagenta
+	case "SUBTASK", "SUB-TASK":
+		return tcell.ColorTeal
+	default:
+		return tcell.ColorDefault
+	}
+}
+
+// Comparator reports whether cell value a should sort before cell value b.
+type Comparator func(a, b string) bool
+
+// sortDirection is the current direction of a column sort.
+type sortDirection int
+
+const (
+	sortAscending sortDirection = iota
+	sortDescending
+)
+
+func (d sortDirection) String() string {
+	if d == sortDescending {
+		return "descending"
+	}
+	return "ascending"
+}
+
+// statusOrder and priorityOrder give STATUS and PRIORITY columns a workflow-aware
+// sort order instead of a lexicographical one.
+var statusOrder = []string{"TO DO", "IN PROGRESS", "DONE"}
+
+var priorityOrder = []string{"HIGHEST", "HIGH", "MEDIUM", "LOW", "LOWEST"}
+
+// defaultComparators maps a header name to the comparator used to sort it.
+var defaultComparators = map[string]Comparator{
+	"VOTES":    numericComparator,
+	"WATCHERS": numericComparator,
+	"CREATED":  dateComparator,
+	"UPDATED":  dateComparator,
+	"STATUS":   orderedComparator(statusOrder),
+	"PRIORITY": orderedComparator(priorityOrder),
+}
+
+func numericComparator(a, b string) bool {
+	an, aerr := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	bn, berr := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if aerr != nil || berr != nil {
+		return strings.ToLower(a) < strings.ToLower(b)
+	}
+	return an < bn
+}
+
+func dateComparator(a, b string) bool {
+	const layout = "2006-01-02 15:04:05"
+
+	at, aerr := time.Parse(layout, strings.TrimSpace(a))
+	bt, berr := time.Parse(layout, strings.TrimSpace(b))
+	if aerr != nil || berr != nil {
+		return strings.ToLower(a) < strings.ToLower(b)
+	}
+	return at.Before(bt)
+}
+
+func orderedComparator(order []string) Comparator {
+	rank := make(map[string]int, len(order))
+	for i, v := range order {
+		rank[v] = i
+	}
+	return func(a, b string) bool {
+		ar, aok := rank[strings.ToUpper(strings.TrimSpace(a))]
+		br, bok := rank[strings.ToUpper(strings.TrimSpace(b))]
+		if aok && bok {
+			return ar < br
+		}
+		if aok != bok {
+			return aok
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	}
+}
+
 // TableData is the data to be displayed in a table.
 type TableData [][]string
 
@@ -103,6 +307,30 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+
+	sortableCols map[string]struct{}
+	sortCol      int
+	sortDir      sortDirection
+
+	cellStyler  CellStyler
+	columnSpecs map[string]ColumnSpec
+
+	autoRefreshInterval time.Duration
+	autoRefreshOn       atomic.Bool
+	autoRefreshStop     chan struct{}
+
+	keyMap KeyMap
+
+	contextMenuFunc ContextMenuFunc
+
+	bulkMoveFunc BulkMoveFunc
+	bulkCopyFunc BulkCopyFunc
+	selected     map[int]struct{}
+
+	allData     TableData
+	filterQuery string
+	filterInput *tview.InputField
+	grid        *tview.Grid
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -112,6 +340,12 @@ type TableOption func(*Table)
 func NewTable(opts ...TableOption) *Table {
 	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
 
+	keyMap, err := LoadKeyMap()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "jira: invalid keymap, falling back to defaults: %s\n", err)
+		keyMap = DefaultKeyMap()
+	}
+
 	tbl := Table{
 		screen:      NewScreen(),
 		view:        tview.NewTable(),
@@ -121,20 +355,30 @@ func NewTable(opts ...TableOption) *Table {
 		action:      getActionModal(),
 		colPad:      defaultColPad,
 		maxColWidth: defaultColWidth,
+		sortCol:     -1,
+		keyMap:      keyMap,
 	}
 	for _, opt := range opts {
 		opt(&tbl)
 	}
+	if tbl.contextMenuFunc == nil {
+		tbl.contextMenuFunc = tbl.DefaultContextMenu
+	}
 
 	tbl.initTable()
 	tbl.initFooter()
 	tbl.initHelp()
+	tbl.initFilter()
 
-	grid := tview.NewGrid().
-		SetRows(0, 1, 2).
+	// The filter row starts collapsed (height 0); showFilter/hideFilter
+	// toggle it so the input only takes up space while actually open.
+	tbl.grid = tview.NewGrid().
+		SetRows(0, 1, 0, 2).
 		AddItem(tbl.view, 0, 0, 1, 1, 0, 0, true).
 		AddItem(tview.NewTextView(), 1, 0, 1, 1, 0, 0, false). // Dummy view to fake row padding.
-		AddItem(tbl.footer, 2, 0, 1, 1, 0, 0, false)
+		AddItem(tbl.filterInput, 2, 0, 1, 1, 0, 0, false).
+		AddItem(tbl.footer, 3, 0, 1, 1, 0, 0, false)
+	grid := tbl.grid
 
 	tbl.action.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
 		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
@@ -215,6 +459,80 @@ func WithCopyKeyFunc(fn CopyKeyFunc) TableOption {
 	}
 }
 
+// WithSortableColumns restricts sorting to the given header names. When not set,
+// every column is sortable.
+func WithSortableColumns(headers []string) TableOption {
+	return func(t *Table) {
+		cols := make(map[string]struct{}, len(headers))
+		for _, h := range headers {
+			cols[strings.ToUpper(h)] = struct{}{}
+		}
+		t.sortableCols = cols
+	}
+}
+
+// WithCellStyler sets the CellStyler used to style each table cell. When not
+// set, a default styler colors STATUS, PRIORITY and TYPE cells and dims
+// resolved rows.
+func WithCellStyler(styler CellStyler) TableOption {
+	return func(t *Table) {
+		t.cellStyler = styler
+	}
+}
+
+// WithColumnSpecs sets per-column expansion, alignment and max width, keyed by
+// header name. Columns without a spec fall back to the table's maxColWidth.
+func WithColumnSpecs(specs []ColumnSpec) TableOption {
+	return func(t *Table) {
+		m := make(map[string]ColumnSpec, len(specs))
+		for _, s := range specs {
+			m[strings.ToUpper(s.Header)] = s
+		}
+		t.columnSpecs = m
+	}
+}
+
+// WithAutoRefresh enables a background ticker that calls refreshFunc every
+// interval while the table is displayed, without stopping the screen.
+func WithAutoRefresh(interval time.Duration) TableOption {
+	return func(t *Table) {
+		t.autoRefreshInterval = interval
+		t.autoRefreshOn.Store(true)
+	}
+}
+
+// WithKeyMap overrides the table's keybindings. When not set, bindings are
+// loaded via LoadKeyMap (defaults merged with $XDG_CONFIG_HOME/jira-cli/keys.yml).
+func WithKeyMap(km KeyMap) TableOption {
+	return func(t *Table) {
+		t.keyMap = km
+	}
+}
+
+// WithContextMenuFunc sets the func that builds the row-level context menu
+// opened with CTRL+Space.
+func WithContextMenuFunc(fn ContextMenuFunc) TableOption {
+	return func(t *Table) {
+		t.contextMenuFunc = fn
+	}
+}
+
+// WithBulkMoveFunc sets a func that is triggered when a user press 'm' with
+// one or more rows selected.
+func WithBulkMoveFunc(fn BulkMoveFunc) TableOption {
+	return func(t *Table) {
+		t.bulkMoveFunc = fn
+	}
+}
+
+// WithBulkCopyFunc sets a func that is triggered when a user press 'c' with
+// one or more rows selected.
+func WithBulkCopyFunc(fn BulkCopyFunc) TableOption {
+	return func(t *Table) {
+		t.bulkCopyFunc = fn
+	}
+}
+
 // WithFixedColumns sets the number of columns that are locked (do not scroll right).
 func WithFixedColumns(cols uint) TableOption {
 	return func(t *Table) {
@@ -227,19 +545,32 @@ func (t *Table) Paint(data TableData) error {
 	if len(data) == 0 {
 		return errNoData
 	}
-	t.data = data
-	t.render(data)
-	
+	prevRow, prevCol := t.view.GetSelection()
+	t.allData = data
+	t.data = t.filteredData()
+	t.render(t.data)
+
+	// Repainting (e.g. after a transition or auto-refresh) should keep the
+	// user where they were rather than jumping back to the first row; fall
+	// back to the first data row on the initial paint, or when the previous
+	// row no longer exists in the new data.
+	switch {
+	case prevRow > 0 && prevRow < len(t.data):
+		t.view.Select(prevRow, prevCol)
+	case len(t.data) > 1:
+		t.view.Select(1, 0)
+	}
+
 	// Schedule an announcement for the initial selection
 	if t.screen.accessibilityEnabled && len(data) > 1 {
 		// Store reference to data for the goroutine
 		dataCopy := data
-		
+
 		// Run in a goroutine with a short delay to ensure the UI is ready
 		go func() {
 			// Give the UI a moment to set the initial selection
 			time.Sleep(100 * time.Millisecond)
-			
+
 			if len(dataCopy) > 1 && len(dataCopy[1]) > 0 {
 				// Show the footer text first (total count info)
 				if t.footerText != "" {
@@ -248,16 +579,68 @@ func (t *Table) Paint(data TableData) error {
 			}
 		}()
 	}
-	
-	return t.screen.Paint(t.painter)
+
+	t.startAutoRefresh()
+	err := t.screen.Paint(t.painter)
+	t.stopAutoRefresh()
+	return err
+}
+
+// startAutoRefresh spawns the ticker goroutine that drives WithAutoRefresh. It
+// is a no-op if auto-refresh was not configured or is already running.
+func (t *Table) startAutoRefresh() {
+	if t.autoRefreshInterval <= 0 || t.refreshFunc == nil || t.autoRefreshStop != nil {
+		return
+	}
+	t.autoRefreshStop = make(chan struct{})
+	stop := t.autoRefreshStop
+
+	go func() {
+		ticker := time.NewTicker(t.autoRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if !t.autoRefreshOn.Load() {
+					continue
+				}
+				if name, _ := t.painter.GetFrontPage(); name == "secondary" || name == "action" || name == "help" {
+					continue
+				}
+				t.screen.QueueUpdateDraw(func() {
+					t.refreshFunc()
+				})
+			}
+		}
+	}()
+}
+
+// stopAutoRefresh stops the ticker goroutine started by startAutoRefresh, if any.
+func (t *Table) stopAutoRefresh() {
+	if t.autoRefreshStop == nil {
+		return
+	}
+	close(t.autoRefreshStop)
+	t.autoRefreshStop = nil
 }
 
 func (t *Table) render(data TableData) {
-	if t.selectedFunc != nil {
-		t.view.SetSelectedFunc(func(r, c int) {
+	// tview.Table does not shrink on SetCell alone; its row count is the
+	// high-water mark of rows ever set. Clear first so a narrower data set
+	// (e.g. a filter query or a refresh with fewer rows) doesn't leave stale
+	// rows from the previous render showing underneath the new ones.
+	t.view.Clear()
+	t.view.SetSelectedFunc(func(r, c int) {
+		if r == 0 {
+			return
+		}
+		if t.selectedFunc != nil {
 			t.selectedFunc(r, c, data)
-		})
-	}
+		}
+	})
 	renderTableHeader(t, data[0])
 	renderTableCell(t, data)
 
@@ -266,48 +649,304 @@ func (t *Table) render(data TableData) {
 		if r > 0 && r < len(data) && c >= 0 && c < len(data[0]) {
 			// Get row data for announcement
 			rowData := data[r]
-			
+
 			// Create announcement with current selection information
 			if len(rowData) > 0 {
 				// Get key/ID and format for screen reader
 				keyCol := 0 // First column is usually ID/key
 				statusCol := data.GetIndex("STATUS")
 				summaryCol := data.GetIndex("SUMMARY")
-				
+
 				var announcement string
-				
+
 				// Build a cleaner announcement with just the important fields
 				if keyCol < len(rowData) {
 					// Start with position info showing current and total
 					announcement = fmt.Sprintf("%d of %d: %s", r, len(data)-1, rowData[keyCol])
-					
+
 					// Add status if available
 					if statusCol >= 0 && statusCol < len(rowData) {
 						announcement += fmt.Sprintf(", %s", rowData[statusCol])
 					}
-					
+
 					// Add summary if available
 					if summaryCol >= 0 && summaryCol < len(rowData) {
 						announcement += fmt.Sprintf(", %s", rowData[summaryCol])
 					}
 				}
-				
+
 				t.screen.AnnounceToScreenReader(announcement)
 			}
 		}
 	})
 }
 
+func (t *Table) isSortable(header string) bool {
+	if t.sortableCols == nil {
+		return true
+	}
+	_, ok := t.sortableCols[strings.ToUpper(header)]
+	return ok
+}
+
+// sortByColumn sorts the data rows by the given column, toggling the sort
+// direction on repeated selections of the same column.
+func (t *Table) sortByColumn(col int) {
+	if len(t.data) == 0 || col < 0 || col >= len(t.data[0]) {
+		return
+	}
+	header := t.data[0][col]
+	if !t.isSortable(header) {
+		return
+	}
+
+	if t.sortCol == col {
+		if t.sortDir == sortAscending {
+			t.sortDir = sortDescending
+		} else {
+			t.sortDir = sortAscending
+		}
+	} else {
+		t.sortCol = col
+		t.sortDir = sortAscending
+	}
+
+	cmp, ok := defaultComparators[strings.ToUpper(header)]
+	if !ok {
+		cmp = func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+	}
+
+	selectedRow, _ := t.view.GetSelection()
+	var selectedKey string
+	if selectedRow > 0 && selectedRow < len(t.data) {
+		selectedKey = t.data.Get(selectedRow, 0)
+	}
+
+	// t.selected is keyed by row index, which sorting invalidates; capture
+	// the selected issue keys so they can be remapped to their new row
+	// indices instead of sticking to whatever issues land in those slots.
+	selectedKeys := make(map[string]struct{}, len(t.selected))
+	for r := range t.selected {
+		if r > 0 && r < len(t.data) {
+			selectedKeys[t.data.Get(r, 0)] = struct{}{}
+		}
+	}
+
+	rows := t.data[1:]
+	sort.SliceStable(rows, func(i, j int) bool {
+		if t.sortDir == sortDescending {
+			return cmp(rows[j][col], rows[i][col])
+		}
+		return cmp(rows[i][col], rows[j][col])
+	})
+
+	if len(selectedKeys) > 0 {
+		remapped := make(map[int]struct{}, len(selectedKeys))
+		for r := 1; r < len(t.data); r++ {
+			if _, ok := selectedKeys[t.data.Get(r, 0)]; ok {
+				remapped[r] = struct{}{}
+			}
+		}
+		t.selected = remapped
+	}
+
+	t.render(t.data)
+
+	newRow := selectedRow
+	if selectedKey != "" {
+		for r := 1; r < len(t.data); r++ {
+			if t.data.Get(r, 0) == selectedKey {
+				newRow = r
+				break
+			}
+		}
+	}
+	t.view.Select(newRow, col)
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Sorted by %s %s", header, t.sortDir))
+	}
+}
+
 func (t *Table) initFooter() {
 	t.footer.
 		SetWordWrap(true).
-		SetText(pad(t.footerText, 1)).
 		SetTextColor(tcell.ColorDefault)
+	t.refreshFooterText()
+}
+
+// refreshFooterText redraws the footer, appending the auto-refresh indicator
+// when WithAutoRefresh is configured.
+func (t *Table) refreshFooterText() {
+	text := t.footerText
+	if t.filterQuery != "" {
+		text = strings.TrimSpace(fmt.Sprintf("%s  [%d/%d matching]", text, len(t.data)-1, len(t.allData)-1))
+	}
+	if t.autoRefreshInterval > 0 {
+		status := "off"
+		if t.autoRefreshOn.Load() {
+			status = t.autoRefreshInterval.String()
+		}
+		text = strings.TrimSpace(fmt.Sprintf("%s  [auto-refresh: %s]", text, status))
+	}
+	t.footer.SetText(pad(text, 1))
+}
+
+// initFilter builds the input field opened by ActionFilter. It is docked
+// above the footer and narrows the table as the user types.
+func (t *Table) initFilter() {
+	t.filterInput = tview.NewInputField().
+		SetLabel("/").
+		SetFieldBackgroundColor(tcell.ColorDefault).
+		SetChangedFunc(func(text string) {
+			t.filterQuery = text
+			t.applyFilter()
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEsc {
+				t.clearFilter()
+			}
+			t.hideFilter()
+			t.screen.SetFocus(t.view)
+		})
+}
+
+// showFilter reveals the filter row and focuses it. It is the counterpart to
+// hideFilter and keeps the row collapsed to zero height until opened, the
+// same way "secondary"/"action"/"help" stay hidden pages until shown.
+func (t *Table) showFilter() {
+	t.grid.SetRows(0, 1, 1, 2)
+	t.screen.SetFocus(t.filterInput)
+}
+
+// hideFilter collapses the filter row back to zero height.
+func (t *Table) hideFilter() {
+	t.grid.SetRows(0, 1, 0, 2)
+}
+
+// applyFilter recomputes t.data from allData using the current filterQuery,
+// re-renders the table and updates the match-count footer.
+func (t *Table) applyFilter() {
+	t.selected = map[int]struct{}{}
+	t.data = t.filteredData()
+	t.render(t.data)
+	if len(t.data) > 1 {
+		t.view.Select(1, 0)
+	}
+	t.refreshFooterText()
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("%d/%d matching", len(t.data)-1, len(t.allData)-1))
+	}
+}
+
+// clearFilter restores the unfiltered data.
+func (t *Table) clearFilter() {
+	t.filterQuery = ""
+	t.filterInput.SetText("")
+	t.selected = map[int]struct{}{}
+	t.data = t.allData
+	t.render(t.data)
+	t.refreshFooterText()
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader("Filter cleared")
+	}
+}
+
+// filteredData returns the rows of allData matching the current filterQuery,
+// or allData unchanged when no filter is active.
+func (t *Table) filteredData() TableData {
+	if t.filterQuery == "" || len(t.allData) == 0 {
+		return t.allData
+	}
+
+	match := t.filterMatcher()
+	out := make(TableData, 1, len(t.allData))
+	out[0] = t.allData[0]
+	for _, row := range t.allData[1:] {
+		if match(row) {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// filterTokenRe tokenizes a filter query into `col:"quoted value"`,
+// `col:value` and bare-word tokens.
+var filterTokenRe = regexp.MustCompile(`(\w+):"([^"]*)"|(\w+):(\S+)|(\S+)`)
+
+// filterMatcher builds the row predicate for the current filterQuery. A
+// "re:" prefix compiles the remainder as a case-insensitive regex matched
+// against the whole row; otherwise the query is split into column-scoped
+// tokens (e.g. status:"in progress") and bare words, all of which must
+// match, case-insensitively, for a row to pass.
+func (t *Table) filterMatcher() func(row []string) bool {
+	query := strings.TrimSpace(t.filterQuery)
+
+	if rest := strings.TrimPrefix(query, "re:"); rest != query {
+		re, err := regexp.Compile("(?i)" + strings.TrimSpace(rest))
+		if err != nil {
+			return func(row []string) bool { return false }
+		}
+		return func(row []string) bool {
+			return re.MatchString(strings.Join(row, "\t"))
+		}
+	}
+
+	type token struct {
+		col   int // -1 for a bare word
+		value string
+	}
+
+	var tokens []token
+	for _, m := range filterTokenRe.FindAllStringSubmatch(query, -1) {
+		switch {
+		case m[1] != "":
+			tokens = append(tokens, token{col: t.allData.GetIndex(m[1]), value: strings.ToLower(m[2])})
+		case m[3] != "":
+			tokens = append(tokens, token{col: t.allData.GetIndex(m[3]), value: strings.ToLower(m[4])})
+		default:
+			tokens = append(tokens, token{col: -1, value: strings.ToLower(m[5])})
+		}
+	}
+
+	return func(row []string) bool {
+		for _, tok := range tokens {
+			if tok.col >= 0 {
+				if tok.col >= len(row) || !strings.Contains(strings.ToLower(row[tok.col]), tok.value) {
+					return false
+				}
+				continue
+			}
+			if !strings.Contains(strings.ToLower(strings.Join(row, "\t")), tok.value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// jumpMatch moves the selection to the next (delta=1) or previous (delta=-1)
+// row, wrapping around. Every row currently visible already matches the
+// active filter, so this is a thin convenience wrapper around navigation.
+func (t *Table) jumpMatch(delta int) {
+	if len(t.data) <= 1 {
+		return
+	}
+	r, c := t.view.GetSelection()
+	n := len(t.data) - 1
+	next := ((r-1+delta)%n+n)%n + 1
+	t.view.Select(next, c)
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("%d of %d: %s", next, n, t.data.Get(next, 0)))
+	}
 }
 
 func (t *Table) initHelp() {
 	t.help.
-		SetInfo(t.helpText).
+		SetInfo(t.renderHelpText()).
 		SetAlign(tview.AlignLeft).
 		SetTitle("USAGE")
 
@@ -319,6 +958,60 @@ func (t *Table) initHelp() {
 	})
 }
 
+// keyMapHelp pairs a keymap action with the human-facing description shown in
+// the help modal.
+var keyMapHelp = []struct {
+	action Action
+	desc   string
+}{
+	{ActionView, "View issue details"},
+	{ActionMove, "Transition issue"},
+	{ActionCopy, "Copy issue"},
+	{ActionCopyKey, "Copy issue key"},
+	{ActionRefresh, "Refresh table"},
+	{ActionAutoRefreshToggle, "Toggle auto-refresh"},
+	{ActionContextMenu, "Open row context menu (or right-click a row)"},
+	{ActionToggleSelect, "Select/deselect row for bulk actions"},
+	{ActionSelectAll, "Select all rows"},
+	{ActionClearSelection, "Clear selection"},
+	{ActionSort, "Sort by column (on header row, or click the header)"},
+	{ActionFilter, "Filter table"},
+	{ActionNextMatch, "Jump to next match"},
+	{ActionPrevMatch, "Jump to previous match"},
+	{ActionHelp, "Show this help"},
+	{ActionQuit, "Quit"},
+	{ActionSpeakCell, "Speak current cell"},
+	{ActionAccessibilityHelp, "Accessibility help"},
+}
+
+// renderHelpText builds the help modal's body from the live keymap, so
+// rebinding a key automatically updates what's shown.
+func (t *Table) renderHelpText() string {
+	km := t.keyMap
+	if km == nil {
+		km = DefaultKeyMap()
+	}
+
+	var b strings.Builder
+	if t.helpText != "" {
+		b.WriteString(t.helpText)
+		b.WriteString("\n\n")
+	}
+	b.WriteString("KEYBINDINGS\n")
+	for _, entry := range keyMapHelp {
+		bindings := km[entry.action]
+		if len(bindings) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(bindings))
+		for _, bnd := range bindings {
+			names = append(names, bnd.String())
+		}
+		fmt.Fprintf(&b, "  %-10s %s\n", strings.Join(names, "/"), entry.desc)
+	}
+	return b.String()
+}
+
 //nolint:gocyclo
 func (t *Table) initTable() {
 	t.view.SetSelectable(true, false).
@@ -329,23 +1022,31 @@ func (t *Table) initTable() {
 			}
 		}).
 		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
-			if ev.Key() == tcell.KeyCtrlR || ev.Key() == tcell.KeyF5 {
+			km := t.keyMap
+			if km == nil {
+				km = DefaultKeyMap()
+			}
+
+			if km.Matches(ev, ActionRefresh) {
 				if t.refreshFunc == nil {
 					return ev
 				}
 				t.screen.Stop()
 				t.refreshFunc()
 			}
-			if ev.Key() == tcell.KeyCtrlK {
+			if km.Matches(ev, ActionCopyKey) {
 				if t.copyKeyFunc == nil {
 					return ev
 				}
 				r, c := t.view.GetSelection()
+				if r == 0 {
+					return ev
+				}
 				t.copyKeyFunc(r, c, t.data)
 			}
 
 			// Accessibility features - announce current cell content
-			if ev.Key() == tcell.KeyCtrlS && t.screen.accessibilityEnabled {
+			if km.Matches(ev, ActionSpeakCell) && t.screen.accessibilityEnabled {
 				r, c := t.view.GetSelection()
 				if r >= 0 && r < len(t.data) && c >= 0 && c < len(t.data[0]) {
 					// Get cell data and header for announcement
@@ -363,159 +1064,498 @@ func (t *Table) initTable() {
 			}
 
 			// Accessibility help
-			if ev.Key() == tcell.KeyCtrlA && t.screen.accessibilityEnabled {
+			if km.Matches(ev, ActionAccessibilityHelp) && t.screen.accessibilityEnabled {
 				accessibilityHelp := "Accessibility shortcuts: Control+S to speak current cell, " +
 					"Control+A for this help, Arrow keys to navigate, Tab to move between sections."
 				t.screen.AnnounceToScreenReader(accessibilityHelp)
 				return nil // Consume event
 			}
 
-			if ev.Key() == tcell.KeyRune {
-				switch ev.Rune() {
-				case 'q':
-					t.screen.Stop()
-					os.Exit(0)
-				case '?':
-					t.painter.ShowPage("help")
-					// Announce help visibility for screen readers
-					if t.screen.accessibilityEnabled {
-						t.screen.AnnounceToScreenReader("Help screen opened. Press q or Escape to close.")
+			switch {
+			case km.Matches(ev, ActionSort):
+				r, c := t.view.GetSelection()
+				if r != 0 {
+					break
+				}
+				t.sortByColumn(c)
+				return nil
+			case km.Matches(ev, ActionQuit):
+				t.screen.Stop()
+				os.Exit(0)
+			case km.Matches(ev, ActionHelp):
+				t.painter.ShowPage("help")
+				// Announce help visibility for screen readers
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader("Help screen opened. Press q or Escape to close.")
+				}
+			case km.Matches(ev, ActionCopy):
+				if len(t.selected) > 0 && t.bulkCopyFunc != nil {
+					t.handleBulkCopy()
+					break
+				}
+				if t.copyFunc == nil {
+					break
+				}
+				r, c := t.view.GetSelection()
+				if r == 0 {
+					break
+				}
+				t.copyFunc(r, c, t.data)
+				// Announce copy action for screen readers
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader("Copied to clipboard")
+				}
+			case km.Matches(ev, ActionAutoRefreshToggle):
+				if t.autoRefreshInterval <= 0 {
+					break
+				}
+				on := !t.autoRefreshOn.Load()
+				t.autoRefreshOn.Store(on)
+				t.refreshFooterText()
+
+				if t.screen.accessibilityEnabled {
+					state := "disabled"
+					if on {
+						state = "enabled"
 					}
-				case 'c':
-					if t.copyFunc == nil {
-						break
+					t.screen.AnnounceToScreenReader(fmt.Sprintf("Auto-refresh %s", state))
+				}
+			case km.Matches(ev, ActionView):
+				if t.viewModeFunc == nil {
+					break
+				}
+				r, c := t.view.GetSelection()
+				if r == 0 {
+					break
+				}
+				t.handleView(r, c)
+			case km.Matches(ev, ActionMove):
+				if len(t.selected) > 0 && t.bulkMoveFunc != nil {
+					t.handleBulkMove()
+					break
+				}
+				if t.moveFunc == nil {
+					break
+				}
+				r, c := t.view.GetSelection()
+				if r == 0 {
+					break
+				}
+				t.handleMove(r, c)
+			case km.Matches(ev, ActionContextMenu):
+				r, c := t.view.GetSelection()
+				if r == 0 {
+					break
+				}
+				t.showContextMenu(r, c)
+			case km.Matches(ev, ActionToggleSelect):
+				t.toggleRowSelection()
+			case km.Matches(ev, ActionSelectAll):
+				t.selectAllRows()
+			case km.Matches(ev, ActionClearSelection):
+				if len(t.selected) == 0 {
+					break
+				}
+				t.clearSelection()
+				return nil
+			case km.Matches(ev, ActionFilter):
+				t.showFilter()
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader("Filter. Type to narrow rows, Escape to clear.")
+				}
+				return nil
+			case km.Matches(ev, ActionNextMatch):
+				t.jumpMatch(1)
+			case km.Matches(ev, ActionPrevMatch):
+				t.jumpMatch(-1)
+			}
+
+			return ev
+		})
+
+	t.view.SetFixed(1, int(t.colFixed))
+
+	t.screen.EnableMouse(true)
+	t.view.SetMouseCapture(t.tableMouseCapture)
+}
+
+// tableMouseCapture lets a header click sort that column (mirroring the
+// Enter-on-header keyboard path) and a right click on a data row open the
+// same context menu as ActionContextMenu, while leaving every other mouse
+// action (row selection, scrolling) to tview's default handling.
+func (t *Table) tableMouseCapture(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	switch action {
+	case tview.MouseLeftClick:
+		x, y := event.Position()
+		r, c := t.view.CellAt(x, y)
+		if r == 0 {
+			t.sortByColumn(c)
+			return tview.MouseConsumed, nil
+		}
+	case tview.MouseRightClick:
+		x, y := event.Position()
+		r, c := t.view.CellAt(x, y)
+		if r > 0 {
+			t.showContextMenu(r, c)
+			return tview.MouseConsumed, nil
+		}
+	}
+	return action, event
+}
+
+// handleView opens the view-mode pager for the issue at (r, c). It is shared
+// by the 'v' keybinding and the row context menu.
+func (t *Table) handleView(r, c int) {
+	// Announce view action for screen readers
+	if t.screen.accessibilityEnabled {
+		var itemDesc string
+		if r >= 0 && r < len(t.data) && len(t.data[r]) > 0 {
+			itemDesc = t.data.Get(r, 0) // Usually first column has ID
+		}
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Viewing details for %s", itemDesc))
+	}
+
+	go func() {
+		func() {
+			t.painter.ShowPage("secondary")
+			defer t.painter.HidePage("secondary")
+
+			dataFn, renderFn := t.viewModeFunc(r, c, t.data)
+
+			out, err := renderFn(dataFn())
+			if err == nil {
+				t.screen.Suspend(func() { _ = PagerOut(out) })
+			}
+		}()
+
+		// Refresh the screen.
+		t.screen.Draw()
+	}()
+}
+
+// handleMove opens the transition modal for the issue at (r, c). It is shared
+// by the 'm' keybinding and the row context menu.
+func (t *Table) handleMove(r, c int) {
+	refreshContextInFooter := func() {
+		footerText := "Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel."
+		t.action.GetFooter().SetText(footerText).SetTextColor(tcell.ColorGray)
+
+		// Announce navigation instructions for screen readers
+		if t.screen.accessibilityEnabled {
+			t.screen.AnnounceToScreenReader(footerText)
+		}
+	}
+
+	go func() {
+		func() {
+			t.painter.ShowPage("secondary").SendToFront("secondary")
+			defer func() {
+				t.painter.HidePage("secondary")
+				t.painter.ShowPage("action")
+			}()
+			refreshContextInFooter()
+
+			key, actions, handler, currentStatus, refreshFunc := t.moveFunc(r, c)()
+
+			// Announce move action for screen readers
+			if t.screen.accessibilityEnabled {
+				actionsStr := strings.Join(actions, ", ")
+				t.screen.AnnounceToScreenReader(
+					fmt.Sprintf("Transition menu for %s. Available options: %s", key, actionsStr))
+			}
+
+			currentStatusIdx := func() int {
+				for i, btn := range actions {
+					if btn == currentStatus {
+						return i
 					}
-					r, c := t.view.GetSelection()
-					t.copyFunc(r, c, t.data)
-					// Announce copy action for screen readers
+				}
+				return 0
+			}
+
+			t.action.ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
+			actionText := fmt.Sprintf("Select desired state to transition %s to:", key)
+			t.action.SetText(actionText)
+
+			t.action.SetDoneFunc(func(btnIndex int, btnLabel string) {
+				processingMsg := "Processing. Please wait..."
+				t.action.GetFooter().SetText(processingMsg).SetTextColor(tcell.ColorGray)
+
+				// Announce processing for screen readers
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader(processingMsg)
+				}
+
+				t.screen.ForceDraw()
+
+				err := handler(btnLabel)
+				if err != nil {
+					errorMsg := fmt.Sprintf("Error: %s", err.Error())
+					t.action.GetFooter().SetText(errorMsg).SetTextColor(tcell.ColorRed)
+
+					// Announce error for screen readers
 					if t.screen.accessibilityEnabled {
-						t.screen.AnnounceToScreenReader("Copied to clipboard")
+						t.screen.AnnounceToScreenReader(errorMsg)
 					}
-				case 'v':
-					if t.viewModeFunc == nil {
-						break
-					}
-					r, c := t.view.GetSelection()
+					return
+				}
+				t.painter.HidePage("action")
+				refreshContextInFooter()
+
+				// Announce success for screen readers
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader(
+						fmt.Sprintf("Successfully transitioned %s to %s", key, btnLabel))
+				}
+
+				if refreshFunc != nil {
+					refreshFunc(r, c, btnLabel)
+					_ = t.Paint(t.allData)
+				}
+			})
+		}()
+
+		// Refresh the screen.
+		t.screen.Draw()
+	}()
+}
+
+// toggleRowSelection adds or removes the currently focused row from the bulk
+// selection set.
+func (t *Table) toggleRowSelection() {
+	r, c := t.view.GetSelection()
+	if r <= 0 {
+		return
+	}
+
+	if t.selected == nil {
+		t.selected = map[int]struct{}{}
+	}
+
+	selected := true
+	if _, ok := t.selected[r]; ok {
+		delete(t.selected, r)
+		selected = false
+	} else {
+		t.selected[r] = struct{}{}
+	}
+
+	t.render(t.data)
+	t.view.Select(r, c)
+
+	if t.screen.accessibilityEnabled {
+		state := "selected"
+		if !selected {
+			state = "deselected"
+		}
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Row %d %s. %d selected", r, state, len(t.selected)))
+	}
+}
+
+// selectAllRows adds every data row to the bulk selection set.
+func (t *Table) selectAllRows() {
+	r, c := t.view.GetSelection()
 
-					// Announce view action for screen readers
+	if t.selected == nil {
+		t.selected = map[int]struct{}{}
+	}
+	for row := 1; row < len(t.data); row++ {
+		t.selected[row] = struct{}{}
+	}
+
+	t.render(t.data)
+	t.view.Select(r, c)
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Selected all %d rows", len(t.selected)))
+	}
+}
+
+// clearSelection empties the bulk selection set.
+func (t *Table) clearSelection() {
+	r, c := t.view.GetSelection()
+
+	t.selected = map[int]struct{}{}
+
+	t.render(t.data)
+	t.view.Select(r, c)
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader("Selection cleared")
+	}
+}
+
+// selectedRows returns the currently selected data rows in ascending order.
+func (t *Table) selectedRows() []int {
+	rows := make([]int, 0, len(t.selected))
+	for r := range t.selected {
+		rows = append(rows, r)
+	}
+	sort.Ints(rows)
+	return rows
+}
+
+// handleBulkCopy runs bulkCopyFunc against the current selection.
+func (t *Table) handleBulkCopy() {
+	rows := t.selectedRows()
+	t.bulkCopyFunc(rows, t.data)
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Copied %d issues", len(rows)))
+	}
+}
+
+// handleBulkMove runs the transition handler returned by bulkMoveFunc against
+// every selected row in turn, streaming progress into the action modal
+// footer and reporting per-row errors at the end without aborting the batch.
+func (t *Table) handleBulkMove() {
+	rows := t.selectedRows()
+	if len(rows) == 0 {
+		return
+	}
+
+	refreshContextInFooter := func() {
+		footerText := "Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel."
+		t.action.GetFooter().SetText(footerText).SetTextColor(tcell.ColorGray)
+		if t.screen.accessibilityEnabled {
+			t.screen.AnnounceToScreenReader(footerText)
+		}
+	}
+
+	go func() {
+		func() {
+			t.painter.ShowPage("secondary").SendToFront("secondary")
+			defer func() {
+				t.painter.HidePage("secondary")
+				t.painter.ShowPage("action")
+			}()
+			refreshContextInFooter()
+
+			actions, handler, refreshFunc := t.bulkMoveFunc(rows)()
+
+			if t.screen.accessibilityEnabled {
+				t.screen.AnnounceToScreenReader(fmt.Sprintf(
+					"Bulk transition menu for %d issues. Available options: %s", len(rows), strings.Join(actions, ", ")))
+			}
+
+			t.action.ClearButtons().AddButtons(actions).SetFocus(0)
+			t.action.SetText(fmt.Sprintf("Select desired state to transition %d selected issues to:", len(rows)))
+
+			t.action.SetDoneFunc(func(btnIndex int, btnLabel string) {
+				t.screen.ForceDraw()
+
+				var failed []string
+				for i, row := range rows {
+					progress := fmt.Sprintf("%d/%d transitioning %s → %s", i+1, len(rows), t.data.Get(row, 0), btnLabel)
+					t.action.GetFooter().SetText(progress).SetTextColor(tcell.ColorGray)
 					if t.screen.accessibilityEnabled {
-						var itemDesc string
-						if r >= 0 && r < len(t.data) && len(t.data[r]) > 0 {
-							itemDesc = t.data.Get(r, 0) // Usually first column has ID
-						}
-						t.screen.AnnounceToScreenReader(fmt.Sprintf("Viewing details for %s", itemDesc))
+						t.screen.AnnounceToScreenReader(progress)
 					}
+					t.screen.ForceDraw()
 
-					go func() {
-						func() {
-							t.painter.ShowPage("secondary")
-							defer t.painter.HidePage("secondary")
-
-							dataFn, renderFn := t.viewModeFunc(r, c, t.data)
-
-							out, err := renderFn(dataFn())
-							if err == nil {
-								t.screen.Suspend(func() { _ = PagerOut(out) })
-							}
-						}()
-
-						// Refresh the screen.
-						t.screen.Draw()
-					}()
-				case 'm':
-					if t.moveFunc == nil {
-						break
+					if err := handler(row, btnLabel); err != nil {
+						failed = append(failed, fmt.Sprintf("%s: %s", t.data.Get(row, 0), err.Error()))
+						continue
 					}
-
-					refreshContextInFooter := func() {
-						footerText := "Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel."
-						t.action.GetFooter().SetText(footerText).SetTextColor(tcell.ColorGray)
-
-						// Announce navigation instructions for screen readers
-						if t.screen.accessibilityEnabled {
-							t.screen.AnnounceToScreenReader(footerText)
-						}
+					if refreshFunc != nil {
+						refreshFunc(row, 0, btnLabel)
 					}
+				}
+
+				t.selected = map[int]struct{}{}
 
-					go func() {
-						func() {
-							t.painter.ShowPage("secondary").SendToFront("secondary")
-							defer func() {
-								t.painter.HidePage("secondary")
-								t.painter.ShowPage("action")
-							}()
-							refreshContextInFooter()
-
-							r, c := t.view.GetSelection()
-							key, actions, handler, currentStatus, refreshFunc := t.moveFunc(r, c)()
-
-							// Announce move action for screen readers
-							if t.screen.accessibilityEnabled {
-								actionsStr := strings.Join(actions, ", ")
-								t.screen.AnnounceToScreenReader(
-									fmt.Sprintf("Transition menu for %s. Available options: %s", key, actionsStr))
-							}
-
-							currentStatusIdx := func() int {
-								for i, btn := range actions {
-									if btn == currentStatus {
-										return i
-									}
-								}
-								return 0
-							}
-
-							t.action.ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
-							actionText := fmt.Sprintf("Select desired state to transition %s to:", key)
-							t.action.SetText(actionText)
-
-							t.action.SetDoneFunc(func(btnIndex int, btnLabel string) {
-								processingMsg := "Processing. Please wait..."
-								t.action.GetFooter().SetText(processingMsg).SetTextColor(tcell.ColorGray)
-
-								// Announce processing for screen readers
-								if t.screen.accessibilityEnabled {
-									t.screen.AnnounceToScreenReader(processingMsg)
-								}
-
-								t.screen.ForceDraw()
-
-								err := handler(btnLabel)
-								if err != nil {
-									errorMsg := fmt.Sprintf("Error: %s", err.Error())
-									t.action.GetFooter().SetText(errorMsg).SetTextColor(tcell.ColorRed)
-
-									// Announce error for screen readers
-									if t.screen.accessibilityEnabled {
-										t.screen.AnnounceToScreenReader(errorMsg)
-									}
-									return
-								}
-								t.painter.HidePage("action")
-								refreshContextInFooter()
-
-								// Announce success for screen readers
-								if t.screen.accessibilityEnabled {
-									t.screen.AnnounceToScreenReader(
-										fmt.Sprintf("Successfully transitioned %s to %s", key, btnLabel))
-								}
-
-								if refreshFunc != nil {
-									refreshFunc(r, c, btnLabel)
-									_ = t.Paint(t.data)
-								}
-							})
-						}()
-
-						// Refresh the screen.
-						t.screen.Draw()
-					}()
+				summary := fmt.Sprintf("Transitioned %d/%d issues to %s", len(rows)-len(failed), len(rows), btnLabel)
+				if len(failed) > 0 {
+					summary += fmt.Sprintf(". %d failed: %s", len(failed), strings.Join(failed, "; "))
+					t.action.GetFooter().SetText(summary).SetTextColor(tcell.ColorRed)
+				} else {
+					t.painter.HidePage("action")
 				}
-			}
-			return ev
+				if t.screen.accessibilityEnabled {
+					t.screen.AnnounceToScreenReader(summary)
+				}
+
+				_ = t.Paint(t.allData)
+			})
+		}()
+
+		t.screen.Draw()
+	}()
+}
+
+// DefaultContextMenu builds a context menu entry for each handler the table
+// already owns ("View", "Transition", "Copy", "Copy key"). Wrap it with
+// WithContextMenuFunc to add entries for actions the table doesn't know
+// about, such as "Assign", "Add comment" or "Open in browser".
+func (t *Table) DefaultContextMenu(row, col int, data TableData) []MenuItem {
+	var items []MenuItem
+
+	if t.viewModeFunc != nil {
+		items = append(items, MenuItem{
+			Label: "View", Shortcut: "v",
+			Handler: func(r, c int, _ TableData) { t.handleView(r, c) },
+		})
+	}
+	if t.moveFunc != nil {
+		items = append(items, MenuItem{
+			Label: "Transition", Shortcut: "m",
+			Handler: func(r, c int, _ TableData) { t.handleMove(r, c) },
 		})
+	}
+	if t.copyFunc != nil {
+		items = append(items, MenuItem{
+			Label: "Copy", Shortcut: "c",
+			Handler: func(r, c int, d TableData) { t.copyFunc(r, c, d) },
+		})
+	}
+	if t.copyKeyFunc != nil {
+		items = append(items, MenuItem{
+			Label: "Copy key", Shortcut: "Ctrl-K",
+			Handler: func(r, c int, d TableData) { t.copyKeyFunc(r, c, d) },
+		})
+	}
+	return items
+}
 
-	t.view.SetFixed(1, int(t.colFixed))
+// showContextMenu opens the row-level action menu built by contextMenuFunc for
+// the cell at (r, c). It reuses the same action modal as handleMove, so only
+// one popup can be visible at a time.
+func (t *Table) showContextMenu(r, c int) {
+	if t.contextMenuFunc == nil {
+		return
+	}
+	items := t.contextMenuFunc(r, c, t.data)
+	if len(items) == 0 {
+		return
+	}
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		if item.Shortcut != "" {
+			labels[i] = fmt.Sprintf("%s (%s)", item.Label, item.Shortcut)
+		} else {
+			labels[i] = item.Label
+		}
+	}
+
+	t.painter.ShowPage("action").SendToFront("action")
+	t.action.GetFooter().
+		SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").
+		SetTextColor(tcell.ColorGray)
+	t.action.ClearButtons().AddButtons(labels).SetFocus(0)
+	t.action.SetText("Actions:")
+
+	t.action.SetDoneFunc(func(btnIndex int, _ string) {
+		t.painter.HidePage("action")
+		if btnIndex < 0 || btnIndex >= len(items) {
+			return
+		}
+		items[btnIndex].Handler(r, c, t.data)
+	})
+
+	if t.screen.accessibilityEnabled {
+		t.screen.AnnounceToScreenReader(fmt.Sprintf("Context menu opened with %d actions", len(items)))
+	}
 }
 
 func renderTableHeader(t *Table, data []string) {
@@ -526,7 +1566,7 @@ func renderTableHeader(t *Table, data []string) {
 
 		cell := tview.NewTableCell(text).
 			SetStyle(style).
-			SetSelectable(false).
+			SetSelectable(true).
 			SetTextColor(tcell.ColorSnow).
 			SetBackgroundColor(tcell.ColorDarkCyan)
 
@@ -537,13 +1577,36 @@ func renderTableHeader(t *Table, data []string) {
 func renderTableCell(t *Table, data TableData) {
 	rows, cols := len(data), len(data[0])
 
+	styler := t.cellStyler
+	if styler == nil {
+		styler = defaultCellStyler{data: data}
+	}
+
 	for r := 1; r < rows; r++ {
 		for c := 0; c < cols; c++ {
-			cell := tview.NewTableCell(pad(data.Get(r, c), t.colPad)).
-				SetMaxWidth(int(t.maxColWidth)).
-				SetTextColor(tcell.ColorDefault)
+			header := data.Get(0, c)
+			value := data.Get(r, c)
+
+			cell := tview.NewTableCell(pad(value, t.colPad)).
+				SetStyle(styler.Style(header, value, r))
+
+			if _, ok := t.selected[r]; ok {
+				cell.SetBackgroundColor(selectionBgColor)
+			}
+
+			spec, ok := t.columnSpecs[strings.ToUpper(header)]
+			switch {
+			case ok && spec.MaxWidth > 0:
+				cell.SetMaxWidth(int(spec.MaxWidth))
+			default:
+				cell.SetMaxWidth(int(t.maxColWidth))
+			}
+			if ok {
+				cell.SetExpansion(spec.Expansion)
+				cell.SetAlign(spec.Align)
+			}
 
 			t.view.SetCell(r, c, cell)
 		}
 	}
-}
\ No newline at end of file
+}